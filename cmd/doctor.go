@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/SrIzan10/sern-cli/pkg/doctor"
+	"github.com/spf13/cobra"
+)
+
+var (
+	doctorLanguage string
+	doctorPackage  string
+	doctorMain     string
+	doctorCommands string
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Verify that a sern project is set up correctly",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		failures := doctor.Run(".", doctorLanguage, doctorPackage, doctorMain, doctorCommands)
+
+		if !doctor.Print(failures) {
+			os.Exit(1)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	doctorCmd.Flags().StringVar(&doctorLanguage, "language", "ts", "project language (ts or js)")
+	doctorCmd.Flags().StringVar(&doctorPackage, "package", "npm", "package manager used by the project")
+	doctorCmd.Flags().StringVar(&doctorMain, "main", "main.ts", "entrypoint file")
+	doctorCmd.Flags().StringVar(&doctorCommands, "commands", "commands", "commands directory")
+
+	rootCmd.AddCommand(doctorCmd)
+}
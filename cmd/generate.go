@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"github.com/SrIzan10/sern-cli/pkg/generate"
+	"github.com/spf13/cobra"
+)
+
+var generateForce bool
+
+var generateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "Scaffold files inside an existing sern project",
+}
+
+func newGenerateSubcommand(kind generate.Kind, use, short string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			description, _ := cmd.Flags().GetString("description")
+
+			return generate.Run(generate.Options{
+				Kind:        kind,
+				Name:        args[0],
+				Description: description,
+				Force:       generateForce,
+			})
+		},
+	}
+
+	cmd.Flags().String("description", "", "short description used inside the generated file")
+
+	return cmd
+}
+
+func init() {
+	generateCmd.PersistentFlags().BoolVar(&generateForce, "force", false, "overwrite the file if it already exists")
+
+	generateCmd.AddCommand(
+		newGenerateSubcommand(generate.KindCommand, "command <name>", "Scaffold a new command"),
+		newGenerateSubcommand(generate.KindEvent, "event <name>", "Scaffold a new event listener"),
+		newGenerateSubcommand(generate.KindModule, "module <name>", "Scaffold a new module"),
+	)
+
+	rootCmd.AddCommand(generateCmd)
+}
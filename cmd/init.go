@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"github.com/SrIzan10/sern-cli/pkg/initialize"
+	"github.com/spf13/cobra"
+)
+
+var initOpts initialize.Options
+
+var initCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Scaffold a new sern project",
+	Run: func(cmd *cobra.Command, args []string) {
+		initialize.Initialize(initOpts)
+	},
+}
+
+func init() {
+	initCmd.Flags().StringVar(&initOpts.Name, "name", "", "project name")
+	initCmd.Flags().StringVar(&initOpts.Language, "language", "", "project language (ts or js)")
+	initCmd.Flags().StringVar(&initOpts.Main, "main", "", "entrypoint file")
+	initCmd.Flags().StringVar(&initOpts.Commands, "commands", "", "commands directory")
+	initCmd.Flags().StringVar(&initOpts.Prefix, "prefix", "", "command prefix")
+	initCmd.Flags().StringVar(&initOpts.Package, "package", "", "package manager")
+	initCmd.Flags().StringVar(&initOpts.Template, "template", "", "template to scaffold from: a registry name, git+https://..., or a local path")
+	initCmd.Flags().BoolVar(&initOpts.Yes, "yes", false, "accept defaults for any unspecified field except --name, which is required, without prompting")
+
+	rootCmd.AddCommand(initCmd)
+}
@@ -0,0 +1,22 @@
+// Package cmd wires up the sern CLI's subcommands.
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "sern",
+	Short: "sern is a CLI for scaffolding and managing sern projects",
+}
+
+// Execute runs the root command, exiting non-zero on failure.
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/SrIzan10/sern-cli/pkg/templates"
+	"github.com/spf13/cobra"
+)
+
+var templatesCmd = &cobra.Command{
+	Use:   "templates",
+	Short: "Manage sern project starter templates",
+}
+
+var templatesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the available starter templates",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		entries, err := templates.List()
+		if err != nil {
+			return err
+		}
+
+		for _, e := range entries {
+			fmt.Printf("%-16s %-6s %s\n", e.Name, e.Language, e.Description)
+		}
+
+		return nil
+	},
+}
+
+var templatesFetchURL string
+
+var templatesFetchCmd = &cobra.Command{
+	Use:   "fetch",
+	Short: "Download the template registry from a remote source and cache it for `templates list`",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return templates.Fetch(templatesFetchURL)
+	},
+}
+
+func init() {
+	templatesFetchCmd.Flags().StringVar(&templatesFetchURL, "url", "", "registry URL to fetch (defaults to the upstream registry)")
+
+	templatesCmd.AddCommand(templatesListCmd, templatesFetchCmd)
+	rootCmd.AddCommand(templatesCmd)
+}
@@ -0,0 +1,7 @@
+package main
+
+import "github.com/SrIzan10/sern-cli/cmd"
+
+func main() {
+	cmd.Execute()
+}
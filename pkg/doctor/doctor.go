@@ -0,0 +1,255 @@
+// Package doctor verifies that a scaffolded (or existing) sern project is
+// actually in a working state.
+package doctor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Check is a single verification performed against a project.
+type Check struct {
+	Name        string
+	Remediation string
+	run         func() error
+}
+
+// Result is the outcome of running a Check.
+type Result struct {
+	Check Check
+	Err   error
+}
+
+// Run executes every check against the project rooted at dir and returns
+// the ones that failed.
+func Run(dir, language, packageManager, mainFile, commandsDir string) []Result {
+	checks := []Check{
+		packageManagerOnPath(packageManager),
+		mainFileCompiles(dir, language, mainFile),
+		commandsDirNonEmpty(filepath.Join(dir, commandsDir)),
+		nodeVersionCompatible(dir),
+		peerDepsCompatible(dir),
+	}
+
+	var failures []Result
+	for _, c := range checks {
+		if err := c.run(); err != nil {
+			failures = append(failures, Result{Check: c, Err: err})
+		}
+	}
+
+	return failures
+}
+
+// Print writes a remediation list for failures to stdout. It returns true
+// if there were no failures.
+func Print(failures []Result) bool {
+	if len(failures) == 0 {
+		fmt.Println("doctor: all checks passed")
+
+		return true
+	}
+
+	fmt.Println("doctor: found issues with this project")
+	for _, f := range failures {
+		fmt.Printf("  x %s: %v\n", f.Check.Name, f.Err)
+		fmt.Printf("    -> %s\n", f.Check.Remediation)
+	}
+
+	return false
+}
+
+func packageManagerOnPath(pm string) Check {
+	return Check{
+		Name:        pm + " on PATH",
+		Remediation: fmt.Sprintf("install %s and make sure it's on your PATH", pm),
+		run: func() error {
+			_, err := exec.LookPath(pm)
+
+			return err
+		},
+	}
+}
+
+func mainFileCompiles(dir, language, mainFile string) Check {
+	mainPath := filepath.Join(dir, mainFile)
+
+	if strings.EqualFold(language, "js") {
+		return Check{
+			Name:        "node --check on " + mainFile,
+			Remediation: "fix the syntax error reported above in " + mainFile,
+			run: func() error {
+				out, err := exec.Command("node", "--check", mainPath).CombinedOutput()
+				if err != nil {
+					return fmt.Errorf("%w\n%s", err, out)
+				}
+
+				return nil
+			},
+		}
+	}
+
+	return Check{
+		Name:        "tsc --noEmit on " + mainFile,
+		Remediation: "fix the type error reported above, or re-run the project's install step",
+		run: func() error {
+			cmd := exec.Command("npx", "--no-install", "tsc", "--noEmit", mainFile)
+			cmd.Dir = dir
+
+			if out, err := cmd.CombinedOutput(); err != nil {
+				return fmt.Errorf("%w\n%s", err, out)
+			}
+
+			return nil
+		},
+	}
+}
+
+func commandsDirNonEmpty(dir string) Check {
+	return Check{
+		Name:        "commands directory is non-empty",
+		Remediation: fmt.Sprintf("run `sern generate command <name>` to scaffold a command into %s", dir),
+		run: func() error {
+			entries, err := os.ReadDir(dir)
+			if err != nil {
+				return err
+			}
+
+			if len(entries) == 0 {
+				return fmt.Errorf("%s has no files", dir)
+			}
+
+			return nil
+		},
+	}
+}
+
+func nodeVersionCompatible(dir string) Check {
+	return Check{
+		Name:        "node version satisfies package.json engines.node",
+		Remediation: "install a Node version matching the \"engines.node\" field in package.json, e.g. via nvm",
+		run: func() error {
+			raw, err := os.ReadFile(filepath.Join(dir, "package.json"))
+			if err != nil {
+				return err
+			}
+
+			var pkg struct {
+				Engines struct {
+					Node string `json:"node"`
+				} `json:"engines"`
+			}
+
+			if err := json.Unmarshal(raw, &pkg); err != nil {
+				return err
+			}
+
+			if pkg.Engines.Node == "" {
+				return nil
+			}
+
+			out, err := exec.Command("node", "--version").Output()
+			if err != nil {
+				return err
+			}
+
+			installed := strings.TrimSpace(string(out))
+
+			installedMajor, err := majorVersion(installed)
+			if err != nil {
+				return nil
+			}
+
+			wantedMajor, err := majorVersion(pkg.Engines.Node)
+			if err != nil {
+				return nil
+			}
+
+			if installedMajor < wantedMajor {
+				return fmt.Errorf("installed node %s does not satisfy required %s", installed, pkg.Engines.Node)
+			}
+
+			return nil
+		},
+	}
+}
+
+// minNodeMajorForDiscordJS maps a discord.js major version to the minimum
+// Node.js major version it (and so sern, which is built on it) requires.
+var minNodeMajorForDiscordJS = map[int]int{
+	14: 16,
+	13: 16,
+	12: 12,
+}
+
+// peerDepsCompatible checks that the installed Node version satisfies
+// whatever discord.js version the template declares as a dependency,
+// since that's the peer dependency sern's own compatibility is pinned to.
+func peerDepsCompatible(dir string) Check {
+	return Check{
+		Name:        "discord.js peer dependency is compatible with installed node",
+		Remediation: "install a Node version compatible with the discord.js version in package.json, or upgrade discord.js",
+		run: func() error {
+			raw, err := os.ReadFile(filepath.Join(dir, "package.json"))
+			if err != nil {
+				return err
+			}
+
+			var pkg struct {
+				Dependencies map[string]string `json:"dependencies"`
+			}
+
+			if err := json.Unmarshal(raw, &pkg); err != nil {
+				return err
+			}
+
+			discordRange, ok := pkg.Dependencies["discord.js"]
+			if !ok {
+				return nil
+			}
+
+			discordMajor, err := majorVersion(discordRange)
+			if err != nil {
+				return nil
+			}
+
+			minNode, ok := minNodeMajorForDiscordJS[discordMajor]
+			if !ok {
+				return nil
+			}
+
+			out, err := exec.Command("node", "--version").Output()
+			if err != nil {
+				return err
+			}
+
+			installed := strings.TrimSpace(string(out))
+
+			nodeMajor, err := majorVersion(installed)
+			if err != nil {
+				return nil
+			}
+
+			if nodeMajor < minNode {
+				return fmt.Errorf("discord.js %s needs node >= %d, installed node is %s", discordRange, minNode, installed)
+			}
+
+			return nil
+		},
+	}
+}
+
+// majorVersion extracts the leading major version number from a semver
+// string or range, e.g. "^14.14.1" or "v18.19.0" both yield 14 and 18.
+func majorVersion(v string) (int, error) {
+	v = strings.TrimLeft(v, "^~>=<v ")
+
+	major, _, _ := strings.Cut(v, ".")
+
+	return strconv.Atoi(major)
+}
@@ -0,0 +1,71 @@
+package doctor
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestMajorVersion(t *testing.T) {
+	cases := map[string]int{
+		"^14.14.1": 14,
+		"~13.2.0":  13,
+		"v18.19.0": 18,
+		"18.19.0":  18,
+		">=16.0.0": 16,
+	}
+
+	for in, want := range cases {
+		got, err := majorVersion(in)
+		if err != nil {
+			t.Fatalf("majorVersion(%q): %v", in, err)
+		}
+
+		if got != want {
+			t.Errorf("majorVersion(%q) = %d, want %d", in, got, want)
+		}
+	}
+}
+
+func TestMajorVersionRejectsGarbage(t *testing.T) {
+	if _, err := majorVersion("latest"); err == nil {
+		t.Fatal("majorVersion(\"latest\") returned nil error")
+	}
+}
+
+// TestNodeVersionCompatibleAcceptsNewerInstalledNode guards against a
+// regression where a range like ">=16.0.0" was compared against an
+// installed "v20.11.0" with strings.Contains, which always failed even
+// though 20 satisfies >=16.
+func TestNodeVersionCompatibleAcceptsNewerInstalledNode(t *testing.T) {
+	if _, err := exec.LookPath("node"); err != nil {
+		t.Skip("node not on PATH")
+	}
+
+	dir := t.TempDir()
+	pkgJSON := `{"engines":{"node":">=16.0.0"}}`
+	if err := os.WriteFile(filepath.Join(dir, "package.json"), []byte(pkgJSON), 0o644); err != nil {
+		t.Fatalf("writing package.json: %v", err)
+	}
+
+	if err := nodeVersionCompatible(dir).run(); err != nil {
+		t.Fatalf("nodeVersionCompatible: %v, want nil since the installed node satisfies >=16.0.0", err)
+	}
+}
+
+func TestNodeVersionCompatibleRejectsOlderInstalledNode(t *testing.T) {
+	if _, err := exec.LookPath("node"); err != nil {
+		t.Skip("node not on PATH")
+	}
+
+	dir := t.TempDir()
+	pkgJSON := `{"engines":{"node":">=9999.0.0"}}`
+	if err := os.WriteFile(filepath.Join(dir, "package.json"), []byte(pkgJSON), 0o644); err != nil {
+		t.Fatalf("writing package.json: %v", err)
+	}
+
+	if err := nodeVersionCompatible(dir).run(); err == nil {
+		t.Fatal("nodeVersionCompatible returned nil error for an impossibly high required version")
+	}
+}
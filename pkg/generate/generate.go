@@ -0,0 +1,172 @@
+// Package generate scaffolds new files (commands, events, modules) into an
+// existing sern project, mirroring the directories declared in the
+// project's sern.config.json.
+package generate
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+const configFileName = "sern.config.json"
+
+// validName matches the file-safe names Run accepts: no path separators,
+// no "..", so a hostile --name can't write outside the target directory.
+var validName = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// Kind identifies the kind of file being scaffolded.
+type Kind string
+
+const (
+	KindCommand Kind = "command"
+	KindEvent   Kind = "event"
+	KindModule  Kind = "module"
+)
+
+// Options configures a single scaffold operation.
+type Options struct {
+	Kind        Kind
+	Name        string
+	Description string
+	Force       bool
+}
+
+// config mirrors the subset of sern.config.json that generate cares about.
+type config struct {
+	Language string `json:"language"`
+	Commands string `json:"commands"`
+	Events   string `json:"events"`
+}
+
+// Run scaffolds a new file of the given kind into the project's configured
+// directory, refusing to overwrite an existing file unless opts.Force is
+// set.
+func Run(opts Options) error {
+	if !validName.MatchString(opts.Name) {
+		return fmt.Errorf("invalid name %q: must match %s", opts.Name, validName.String())
+	}
+
+	cfg, err := readConfig(".")
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", configFileName, err)
+	}
+
+	dir, err := targetDir(cfg, opts.Kind)
+	if err != nil {
+		return err
+	}
+
+	dest := filepath.Join(dir, fmt.Sprintf("%s.%s", opts.Name, extensionFor(cfg.Language)))
+
+	if _, err := os.Stat(dest); err == nil && !opts.Force {
+		return fmt.Errorf("%s already exists, pass --force to overwrite", dest)
+	}
+
+	tmpl, err := loadTemplate(opts.Kind, cfg.Language)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("creating %s: %w", dir, err)
+	}
+
+	f, err := os.Create(dest)
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", dest, err)
+	}
+	defer f.Close()
+
+	data := struct {
+		Name        string
+		Description string
+	}{Name: opts.Name, Description: opts.Description}
+
+	if err := tmpl.Execute(f, data); err != nil {
+		return fmt.Errorf("rendering template for %s: %w", dest, err)
+	}
+
+	fmt.Printf("created %s\n", dest)
+
+	return nil
+}
+
+func readConfig(dir string) (config, error) {
+	raw, err := os.ReadFile(filepath.Join(dir, configFileName))
+	if err != nil {
+		return config{}, err
+	}
+
+	var cfg config
+	if err := json.Unmarshal(raw, &cfg); err != nil {
+		return config{}, fmt.Errorf("parsing %s: %w", configFileName, err)
+	}
+
+	if cfg.Language == "" {
+		cfg.Language = "ts"
+	}
+	if cfg.Commands == "" {
+		cfg.Commands = "commands"
+	}
+	if cfg.Events == "" {
+		cfg.Events = "events"
+	}
+
+	return cfg, nil
+}
+
+func targetDir(cfg config, kind Kind) (string, error) {
+	switch kind {
+	case KindCommand:
+		return cfg.Commands, nil
+	case KindEvent:
+		return cfg.Events, nil
+	case KindModule:
+		return filepath.Join(cfg.Commands, "modules"), nil
+	default:
+		return "", fmt.Errorf("unknown generate kind %q", kind)
+	}
+}
+
+func extensionFor(language string) string {
+	if strings.EqualFold(language, "js") {
+		return "js"
+	}
+
+	return "ts"
+}
+
+// templateFuncs are available inside the embedded .tmpl files. js renders
+// a value as a quoted, escaped JS/TS string literal, so user-supplied
+// text (opts.Description in particular) can't break out of the
+// surrounding string when it contains a quote or backslash.
+var templateFuncs = template.FuncMap{
+	"js": func(s string) (string, error) {
+		quoted, err := json.Marshal(s)
+		if err != nil {
+			return "", err
+		}
+
+		return string(quoted), nil
+	},
+}
+
+func loadTemplate(kind Kind, language string) (*template.Template, error) {
+	name := fmt.Sprintf("templates/%s.%s.tmpl", kind, extensionFor(language))
+
+	raw, err := templateFS.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("no template for %s/%s: %w", kind, language, err)
+	}
+
+	return template.New(name).Funcs(templateFuncs).Parse(string(raw))
+}
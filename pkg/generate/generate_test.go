@@ -0,0 +1,107 @@
+package generate
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// chdirToProject creates a throwaway sern.config.json-having project
+// directory, chdirs into it for the duration of the test, and restores
+// the original working directory afterwards.
+func chdirToProject(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, configFileName), []byte(`{"language":"ts"}`), 0o644); err != nil {
+		t.Fatalf("writing %s: %v", configFileName, err)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Getwd: %v", err)
+	}
+
+	if err := os.Chdir(dir); err != nil {
+		t.Fatalf("Chdir: %v", err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+
+	return dir
+}
+
+func TestTargetDir(t *testing.T) {
+	cfg := config{Commands: "commands", Events: "events"}
+
+	cases := []struct {
+		kind Kind
+		want string
+	}{
+		{KindCommand, "commands"},
+		{KindEvent, "events"},
+		{KindModule, "commands/modules"},
+	}
+
+	for _, c := range cases {
+		got, err := targetDir(cfg, c.kind)
+		if err != nil {
+			t.Fatalf("targetDir(%q): %v", c.kind, err)
+		}
+
+		if got != c.want {
+			t.Errorf("targetDir(%q) = %q, want %q", c.kind, got, c.want)
+		}
+	}
+}
+
+func TestTargetDirUnknownKind(t *testing.T) {
+	if _, err := targetDir(config{}, Kind("bogus")); err == nil {
+		t.Fatal("targetDir with an unknown kind returned nil error")
+	}
+}
+
+func TestExtensionFor(t *testing.T) {
+	cases := map[string]string{
+		"ts": "ts",
+		"TS": "ts",
+		"js": "js",
+		"JS": "js",
+		"":   "ts",
+	}
+
+	for language, want := range cases {
+		if got := extensionFor(language); got != want {
+			t.Errorf("extensionFor(%q) = %q, want %q", language, got, want)
+		}
+	}
+}
+
+func TestRunEscapesQuotesInDescription(t *testing.T) {
+	dir := chdirToProject(t)
+
+	opts := Options{Kind: KindCommand, Name: "foo", Description: `it's "broken"`}
+	if err := Run(opts); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	out, err := os.ReadFile(filepath.Join(dir, "commands", "foo.ts"))
+	if err != nil {
+		t.Fatalf("reading generated file: %v", err)
+	}
+
+	if !strings.Contains(string(out), `description: "it's \"broken\""`) {
+		t.Fatalf("generated file doesn't contain a safely escaped description:\n%s", out)
+	}
+}
+
+func TestRunRejectsPathTraversalInName(t *testing.T) {
+	chdirToProject(t)
+
+	hostile := []string{"../../../../tmp/pwned", "sub/dir", `back\slash`}
+	for _, name := range hostile {
+		if err := Run(Options{Kind: KindCommand, Name: name}); err == nil {
+			t.Errorf("Run with name %q returned nil error, want rejection", name)
+		}
+	}
+}
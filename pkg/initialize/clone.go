@@ -0,0 +1,19 @@
+package initialize
+
+import "github.com/SrIzan10/sern-cli/pkg/vcs"
+
+// cloneRepository fetches the template at source into a new dest
+// directory, picking a git, tarball, or local vcs.Repo according to kind,
+// and returns the resolved revision for .sern-template.lock bookkeeping.
+func cloneRepository(dest, kind, source string) (string, error) {
+	repo, err := vcs.New(kind, source)
+	if err != nil {
+		return "", err
+	}
+
+	if err := repo.Clone(dest); err != nil {
+		return "", err
+	}
+
+	return vcs.Revision(dest), nil
+}
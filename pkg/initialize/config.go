@@ -0,0 +1,44 @@
+package initialize
+
+import (
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// configFileNames are checked in order in each of configDirs.
+var configFileNames = []string{".sernrc.yaml", "sern.init.yaml"}
+
+// loadConfigFile looks for a recognized config file in the current working
+// directory, falling back to the user's home directory, and returns the
+// options it contains. A missing file is not an error.
+func loadConfigFile() (Options, error) {
+	for _, dir := range configDirs() {
+		for _, name := range configFileNames {
+			raw, err := os.ReadFile(filepath.Join(dir, name))
+			if err != nil {
+				continue
+			}
+
+			var opts Options
+			if err := yaml.Unmarshal(raw, &opts); err != nil {
+				return Options{}, err
+			}
+
+			return opts, nil
+		}
+	}
+
+	return Options{}, nil
+}
+
+func configDirs() []string {
+	dirs := []string{"."}
+
+	if home, err := os.UserHomeDir(); err == nil {
+		dirs = append(dirs, home)
+	}
+
+	return dirs
+}
@@ -4,29 +4,175 @@ import (
 	"fmt"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/SrIzan10/sern-cli/pkg/doctor"
+	"github.com/SrIzan10/sern-cli/pkg/templates"
 )
 
-func Initialize() {
-	answers := struct {
-		Name     string
-		Language string
-		Main     string
-		Commands string
-		Prefix   string
-		Package  string
-	}{}
+// initAnswers mirrors the fields survey.Ask can fill in from `questions`.
+type initAnswers struct {
+	Name     string
+	Language string
+	Main     string
+	Commands string
+	Prefix   string
+	Package  string
+}
+
+// Initialize scaffolds a new sern project. Any field of opts left empty is
+// resolved from a .sernrc.yaml/sern.init.yaml config file and, failing
+// that, an interactive survey — unless opts.Yes is set, in which case
+// built-in defaults are used instead and the survey is skipped entirely.
+func Initialize(opts Options) {
+	fileDefaults, err := loadConfigFile()
+	if err != nil {
+		fmt.Println("Failed to read init config file:", err)
+
+		return
+	}
+
+	opts = merge(opts, fileDefaults)
+
+	if opts.Yes {
+		opts, err = applyDefaults(opts)
+		if err != nil {
+			fmt.Println("Project initialization failed:", err)
+
+			return
+		}
+
+		if opts.Name == "" {
+			fmt.Println("Project initialization failed: --name is required when using --yes")
+
+			return
+		}
+	} else if missing := missingQuestions(opts); len(missing) > 0 {
+		var answers initAnswers
+
+		if err := survey.Ask(missing, &answers); err != nil {
+			fmt.Println("Project initialization failed, exiting.")
+
+			return
+		}
+
+		opts = fillFromAnswers(opts, answers)
+	}
+
+	template := opts.Template
+	if template == "" {
+		template, err = promptTemplate(opts.Language)
+		if err != nil {
+			fmt.Println(err)
+
+			return
+		}
+	}
 
-	err := survey.Ask(questions, &answers)
+	source, err := templates.Resolve(template)
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
 
+	revision, err := cloneRepository(opts.Name, source.Kind, source.Location)
 	if err != nil {
-		fmt.Println("Project initialization failed, exiting.")
+		fmt.Println(err)
+
+		return
+	}
+
+	lock := templates.Lock{Name: template, Source: source.Location, Revision: revision}
+	if err := templates.WriteLock(opts.Name, lock); err != nil {
+		fmt.Println("Failed to write template lockfile:", err)
+	}
+
+	if err := renameFolders(opts.Name, opts.Main, opts.Commands); err != nil {
+		fmt.Println("Failed to apply project layout:", err)
 
 		return
 	}
 
-	cloneRepository(answers.Name, answers.Language)
+	if err := installDependencies(opts.Name, opts.Package); err != nil {
+		fmt.Println("Failed to install dependencies:", err)
 
-	renameFolders(answers.Name, answers.Main, answers.Commands)
+		return
+	}
+
+	doctor.Print(doctor.Run(opts.Name, opts.Language, opts.Package, opts.Main, opts.Commands))
+}
+
+// missingQuestions returns the subset of `questions` whose answer isn't
+// already resolvable from opts.
+func missingQuestions(opts Options) []*survey.Question {
+	resolved := map[string]bool{
+		"Name":     opts.Name != "",
+		"Language": opts.Language != "",
+		"Main":     opts.Main != "",
+		"Commands": opts.Commands != "",
+		"Prefix":   opts.Prefix != "",
+		"Package":  opts.Package != "",
+	}
+
+	var missing []*survey.Question
+	for _, q := range questions {
+		if !resolved[q.Name] {
+			missing = append(missing, q)
+		}
+	}
+
+	return missing
+}
+
+// fillFromAnswers copies any field answers provides into opts' matching
+// empty fields, without overwriting values opts already had.
+func fillFromAnswers(opts Options, answers initAnswers) Options {
+	if opts.Name == "" {
+		opts.Name = answers.Name
+	}
+	if opts.Language == "" {
+		opts.Language = answers.Language
+	}
+	if opts.Main == "" {
+		opts.Main = answers.Main
+	}
+	if opts.Commands == "" {
+		opts.Commands = answers.Commands
+	}
+	if opts.Prefix == "" {
+		opts.Prefix = answers.Prefix
+	}
+	if opts.Package == "" {
+		opts.Package = answers.Package
+	}
+
+	return opts
+}
+
+// promptTemplate asks the user to pick a starter template matching the
+// chosen language from the registry templates.List returns (the cached
+// fetched one if present, otherwise the bundled one).
+func promptTemplate(language string) (string, error) {
+	entries, err := templates.List()
+	if err != nil {
+		return "", err
+	}
+
+	var options []string
+	for _, e := range entries {
+		if e.Language == language {
+			options = append(options, e.Name)
+		}
+	}
+
+	var choice string
+	prompt := &survey.Select{
+		Message: "Which starter template would you like to use?",
+		Options: options,
+	}
+
+	if err := survey.AskOne(prompt, &choice); err != nil {
+		return "", err
+	}
 
-	installDependencies(answers.Name, answers.Package)
+	return choice, nil
 }
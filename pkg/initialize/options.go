@@ -0,0 +1,97 @@
+package initialize
+
+import (
+	"fmt"
+
+	"github.com/SrIzan10/sern-cli/pkg/templates"
+)
+
+// Options holds the values Initialize needs to scaffold a project. Any
+// field left empty is filled in by prompting the user, unless Yes is set,
+// in which case built-in defaults are used instead; Name has no default
+// and must be given when Yes is set.
+type Options struct {
+	Name     string
+	Language string
+	Main     string
+	Commands string
+	Prefix   string
+	Package  string
+	Template string
+	Yes      bool
+}
+
+// applyDefaults fills in empty fields of opts with sensible defaults, for
+// use when Yes is set and the field wasn't otherwise resolvable. Template
+// defaults to the first registry entry matching Language, so --yes never
+// falls through to the interactive template survey; it's an error if no
+// registry entry matches.
+func applyDefaults(opts Options) (Options, error) {
+	if opts.Language == "" {
+		opts.Language = "ts"
+	}
+	if opts.Main == "" {
+		opts.Main = "main." + opts.Language
+	}
+	if opts.Commands == "" {
+		opts.Commands = "commands"
+	}
+	if opts.Prefix == "" {
+		opts.Prefix = "!"
+	}
+	if opts.Package == "" {
+		opts.Package = "npm"
+	}
+
+	if opts.Template == "" {
+		entries, err := templates.List()
+		if err != nil {
+			return opts, err
+		}
+
+		for _, e := range entries {
+			if e.Language == opts.Language {
+				opts.Template = e.Name
+
+				break
+			}
+		}
+
+		if opts.Template == "" {
+			return opts, fmt.Errorf("no default template for language %q; pass --template explicitly", opts.Language)
+		}
+	}
+
+	return opts, nil
+}
+
+// merge fills empty fields of opts with the corresponding field from
+// defaults, without overwriting anything opts already set.
+func merge(opts, defaults Options) Options {
+	if opts.Name == "" {
+		opts.Name = defaults.Name
+	}
+	if opts.Language == "" {
+		opts.Language = defaults.Language
+	}
+	if opts.Main == "" {
+		opts.Main = defaults.Main
+	}
+	if opts.Commands == "" {
+		opts.Commands = defaults.Commands
+	}
+	if opts.Prefix == "" {
+		opts.Prefix = defaults.Prefix
+	}
+	if opts.Package == "" {
+		opts.Package = defaults.Package
+	}
+	if opts.Template == "" {
+		opts.Template = defaults.Template
+	}
+	if defaults.Yes {
+		opts.Yes = true
+	}
+
+	return opts
+}
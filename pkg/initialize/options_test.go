@@ -0,0 +1,93 @@
+package initialize
+
+import "testing"
+
+func TestMergeKeepsExistingFields(t *testing.T) {
+	opts := Options{Name: "my-bot", Language: "js"}
+	defaults := Options{Name: "default-name", Main: "main.ts", Yes: true}
+
+	got := merge(opts, defaults)
+
+	if got.Name != "my-bot" {
+		t.Errorf("Name = %q, want %q (opts should win over defaults)", got.Name, "my-bot")
+	}
+	if got.Language != "js" {
+		t.Errorf("Language = %q, want %q", got.Language, "js")
+	}
+	if got.Main != "main.ts" {
+		t.Errorf("Main = %q, want %q (empty opts field should fall back to defaults)", got.Main, "main.ts")
+	}
+	if !got.Yes {
+		t.Error("Yes = false, want true once a default sets it")
+	}
+}
+
+func TestApplyDefaultsFillsEmptyFieldsOnly(t *testing.T) {
+	opts := Options{Language: "js"}
+
+	got, err := applyDefaults(opts)
+	if err != nil {
+		t.Fatalf("applyDefaults: %v", err)
+	}
+
+	if got.Language != "js" {
+		t.Errorf("Language = %q, want unchanged %q", got.Language, "js")
+	}
+	if got.Main != "main.js" {
+		t.Errorf("Main = %q, want %q", got.Main, "main.js")
+	}
+	if got.Commands != "commands" {
+		t.Errorf("Commands = %q, want %q", got.Commands, "commands")
+	}
+	if got.Package != "npm" {
+		t.Errorf("Package = %q, want %q", got.Package, "npm")
+	}
+	if got.Template == "" {
+		t.Error("Template is empty, want a default registry entry so --yes never prompts")
+	}
+}
+
+func TestApplyDefaultsKeepsExplicitTemplate(t *testing.T) {
+	opts := Options{Language: "ts", Template: "sharding-ts"}
+
+	got, err := applyDefaults(opts)
+	if err != nil {
+		t.Fatalf("applyDefaults: %v", err)
+	}
+
+	if got.Template != "sharding-ts" {
+		t.Errorf("Template = %q, want unchanged %q", got.Template, "sharding-ts")
+	}
+}
+
+func TestApplyDefaultsErrorsWithNoMatchingTemplate(t *testing.T) {
+	opts := Options{Language: "not-a-real-language"}
+
+	if _, err := applyDefaults(opts); err == nil {
+		t.Fatal("applyDefaults with no matching registry entry returned nil error")
+	}
+}
+
+func TestFillFromAnswersDoesNotOverwriteOpts(t *testing.T) {
+	opts := Options{Name: "my-bot"}
+	answers := initAnswers{Name: "ignored", Language: "ts", Main: "main.ts"}
+
+	got := fillFromAnswers(opts, answers)
+
+	if got.Name != "my-bot" {
+		t.Errorf("Name = %q, want unchanged %q", got.Name, "my-bot")
+	}
+	if got.Language != "ts" {
+		t.Errorf("Language = %q, want %q from answers", got.Language, "ts")
+	}
+}
+
+func TestMissingQuestionsOmitsResolvedFields(t *testing.T) {
+	opts := Options{Name: "my-bot", Language: "ts", Main: "main.ts", Commands: "commands", Prefix: "!"}
+
+	missing := missingQuestions(opts)
+
+	if len(missing) != 1 || missing[0].Name != "Package" {
+		t.Fatalf("missingQuestions(%+v) = %v, want only the Package question", opts, missing)
+	}
+}
@@ -0,0 +1,42 @@
+package initialize
+
+import "github.com/AlecAivazis/survey/v2"
+
+// questions are the interactive prompts used to fill in any Options field
+// not already resolved from flags or a config file. missingQuestions
+// filters this list down to the ones still needed.
+var questions = []*survey.Question{
+	{
+		Name:     "Name",
+		Prompt:   &survey.Input{Message: "Project name:"},
+		Validate: survey.Required,
+	},
+	{
+		Name: "Language",
+		Prompt: &survey.Select{
+			Message: "Language:",
+			Options: []string{"ts", "js"},
+			Default: "ts",
+		},
+	},
+	{
+		Name:   "Main",
+		Prompt: &survey.Input{Message: "Entrypoint file:", Default: "main.ts"},
+	},
+	{
+		Name:   "Commands",
+		Prompt: &survey.Input{Message: "Commands directory:", Default: "commands"},
+	},
+	{
+		Name:   "Prefix",
+		Prompt: &survey.Input{Message: "Command prefix:", Default: "!"},
+	},
+	{
+		Name: "Package",
+		Prompt: &survey.Select{
+			Message: "Package manager:",
+			Options: []string{"npm", "yarn", "pnpm", "bun"},
+			Default: "npm",
+		},
+	},
+}
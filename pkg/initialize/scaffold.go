@@ -0,0 +1,66 @@
+package initialize
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// defaultMainNames are the entrypoint filenames every bundled template
+// ships with, one per supported language.
+var defaultMainNames = []string{"main.ts", "main.js"}
+
+// defaultCommands is the commands directory name every bundled template
+// ships with (matching the generate package's own default).
+const defaultCommands = "commands"
+
+// renameFolders moves the cloned template's conventional entrypoint file
+// and commands directory into place under dir, if the user chose
+// different names than the template's defaults.
+func renameFolders(dir, main, commands string) error {
+	if main != "" {
+		for _, candidate := range defaultMainNames {
+			if candidate == main {
+				continue
+			}
+
+			src := filepath.Join(dir, candidate)
+			if _, err := os.Stat(src); err != nil {
+				continue
+			}
+
+			if err := os.Rename(src, filepath.Join(dir, main)); err != nil {
+				return fmt.Errorf("renaming %s to %s: %w", candidate, main, err)
+			}
+
+			break
+		}
+	}
+
+	if commands != "" && commands != defaultCommands {
+		src := filepath.Join(dir, defaultCommands)
+		if _, err := os.Stat(src); err != nil {
+			return nil
+		}
+
+		if err := os.Rename(src, filepath.Join(dir, commands)); err != nil {
+			return fmt.Errorf("renaming %s to %s: %w", defaultCommands, commands, err)
+		}
+	}
+
+	return nil
+}
+
+// installDependencies runs the chosen package manager's install command
+// inside dir.
+func installDependencies(dir, packageManager string) error {
+	cmd := exec.Command(packageManager, "install")
+	cmd.Dir = dir
+
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%s install: %w\n%s", packageManager, err, out)
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package templates
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// LockFileName is the name of the file recording which template a project
+// was scaffolded from.
+const LockFileName = ".sern-template.lock"
+
+// Lock records the template a project was generated from, so the exact
+// source can be reproduced later.
+type Lock struct {
+	Name      string    `json:"name,omitempty"`
+	Source    string    `json:"source"`
+	Revision  string    `json:"revision,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// WriteLock records l as dir/.sern-template.lock.
+func WriteLock(dir string, l Lock) error {
+	l.CreatedAt = time.Now().UTC()
+
+	raw, err := json.MarshalIndent(l, "", "\t")
+	if err != nil {
+		return fmt.Errorf("encoding %s: %w", LockFileName, err)
+	}
+
+	return os.WriteFile(filepath.Join(dir, LockFileName), raw, 0o644)
+}
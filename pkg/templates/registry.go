@@ -0,0 +1,105 @@
+// Package templates maintains the registry of starter templates that
+// `sern init` can scaffold a new project from, plus resolution of ad-hoc
+// git and local sources.
+package templates
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+//go:embed registry.json
+var bundledRegistry []byte
+
+const defaultRegistryURL = "https://raw.githubusercontent.com/SrIzan10/sern-cli/main/pkg/templates/registry.json"
+
+// cacheFileName is the name Fetch caches the downloaded registry under,
+// inside the user's cache directory.
+const cacheFileName = "registry.json"
+
+// Entry describes a single starter template that `sern init` can scaffold
+// from.
+type Entry struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Source      string `json:"source"`
+	Language    string `json:"language"`
+}
+
+// List returns the most recently fetched registry, if `sern templates
+// fetch` has cached one locally, otherwise the registry bundled into the
+// binary at build time.
+func List() ([]Entry, error) {
+	raw := bundledRegistry
+
+	if path, err := cachePath(); err == nil {
+		if cached, err := os.ReadFile(path); err == nil {
+			raw = cached
+		}
+	}
+
+	var entries []Entry
+	if err := json.Unmarshal(raw, &entries); err != nil {
+		return nil, fmt.Errorf("parsing template registry: %w", err)
+	}
+
+	return entries, nil
+}
+
+// Fetch downloads the registry from url, falling back to the upstream
+// registry when url is empty, and caches it on disk for List to pick up.
+// The registry bundled into the binary via go:embed is never modified;
+// there's no source tree to write back to at runtime.
+func Fetch(url string) error {
+	if url == "" {
+		url = defaultRegistryURL
+	}
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return fmt.Errorf("fetching template registry: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetching template registry: unexpected status %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading template registry response: %w", err)
+	}
+
+	var entries []Entry
+	if err := json.Unmarshal(body, &entries); err != nil {
+		return fmt.Errorf("parsing fetched template registry: %w", err)
+	}
+
+	path, err := cachePath()
+	if err != nil {
+		return fmt.Errorf("locating template registry cache: %w", err)
+	}
+
+	return os.WriteFile(path, body, 0o644)
+}
+
+// cachePath returns the file Fetch writes to and List reads from,
+// creating its parent directory if necessary.
+func cachePath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	dir = filepath.Join(dir, "sern")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, cacheFileName), nil
+}
@@ -0,0 +1,50 @@
+package templates
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// withIsolatedCache points os.UserCacheDir at a fresh temp directory for
+// the duration of the test, so Fetch/List don't touch the real machine's
+// cache.
+func withIsolatedCache(t *testing.T) {
+	t.Helper()
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+}
+
+func TestFetchCachesRegistryForList(t *testing.T) {
+	withIsolatedCache(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"name":"fetched-template","description":"d","source":"s","language":"ts"}]`))
+	}))
+	defer srv.Close()
+
+	if err := Fetch(srv.URL); err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+
+	entries, err := List()
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+
+	if len(entries) != 1 || entries[0].Name != "fetched-template" {
+		t.Fatalf("List() = %+v, want the fetched registry, not the bundled one", entries)
+	}
+}
+
+func TestListFallsBackToBundledRegistry(t *testing.T) {
+	withIsolatedCache(t)
+
+	entries, err := List()
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+
+	if len(entries) == 0 {
+		t.Fatal("List() returned no entries; expected the bundled registry")
+	}
+}
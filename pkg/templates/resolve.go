@@ -0,0 +1,48 @@
+package templates
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Source describes where to obtain a template's files from.
+type Source struct {
+	// Kind is one of "registry", "git", or "local".
+	Kind string
+	// Location is the registry entry's source URL, a git remote, or a
+	// filesystem path.
+	Location string
+}
+
+// Resolve interprets a --template value into a concrete Source. ref may be
+// the name of a registry entry, a "git+https://..." URL, or a local path
+// starting with "./", "../" or "/"; any other value is looked up as a
+// registry name.
+func Resolve(ref string) (Source, error) {
+	switch {
+	case strings.HasPrefix(ref, "git+"):
+		return Source{Kind: "git", Location: strings.TrimPrefix(ref, "git+")}, nil
+
+	case strings.HasPrefix(ref, "./"), strings.HasPrefix(ref, "../"), strings.HasPrefix(ref, "/"):
+		if _, err := os.Stat(ref); err != nil {
+			return Source{}, fmt.Errorf("local template %q: %w", ref, err)
+		}
+
+		return Source{Kind: "local", Location: ref}, nil
+
+	default:
+		entries, err := List()
+		if err != nil {
+			return Source{}, err
+		}
+
+		for _, e := range entries {
+			if e.Name == ref {
+				return Source{Kind: "registry", Location: e.Source}, nil
+			}
+		}
+
+		return Source{}, fmt.Errorf("unknown template %q", ref)
+	}
+}
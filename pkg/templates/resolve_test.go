@@ -0,0 +1,56 @@
+package templates
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveGitPrefix(t *testing.T) {
+	got, err := Resolve("git+https://example.com/repo.git")
+	if err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+
+	want := Source{Kind: "git", Location: "https://example.com/repo.git"}
+	if got != want {
+		t.Errorf("Resolve = %+v, want %+v", got, want)
+	}
+}
+
+func TestResolveLocalPath(t *testing.T) {
+	dir := t.TempDir()
+
+	got, err := Resolve(dir)
+	if err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+
+	if got.Kind != "local" || got.Location != dir {
+		t.Errorf("Resolve(%q) = %+v, want local path", dir, got)
+	}
+}
+
+func TestResolveMissingLocalPath(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "does-not-exist")
+
+	if _, err := Resolve(missing); err == nil {
+		t.Fatalf("Resolve(%q) = nil error, want one for a missing path", missing)
+	}
+}
+
+func TestResolveRegistryEntry(t *testing.T) {
+	got, err := Resolve("minimal-ts")
+	if err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+
+	if got.Kind != "registry" || got.Location == "" {
+		t.Errorf("Resolve(%q) = %+v, want a resolved registry entry", "minimal-ts", got)
+	}
+}
+
+func TestResolveUnknownName(t *testing.T) {
+	if _, err := Resolve("not-a-real-template"); err == nil {
+		t.Fatal("Resolve of an unknown template name returned nil error")
+	}
+}
@@ -0,0 +1,70 @@
+package vcs
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// GitRepo clones templates from a git remote using the system git binary.
+type GitRepo struct {
+	URL string
+
+	dir string
+}
+
+// NewGitRepo returns a Repo backed by the git binary on PATH.
+func NewGitRepo(url string) *GitRepo {
+	return &GitRepo{URL: url}
+}
+
+func (r *GitRepo) Clone(dest string) error {
+	cmd := exec.Command("git", cloneArgs(r.URL, dest)...)
+
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("git clone %s: %w\n%s", r.URL, err, out)
+	}
+
+	r.dir = dest
+
+	return nil
+}
+
+func (r *GitRepo) Checkout(ref string) error {
+	if strings.HasPrefix(ref, "-") {
+		return fmt.Errorf("invalid ref %q: must not start with '-'", ref)
+	}
+
+	cmd := exec.Command("git", append([]string{"-C", r.dir}, checkoutArgs(ref)...)...)
+
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("git checkout %s: %w\n%s", ref, err, out)
+	}
+
+	return nil
+}
+
+func (r *GitRepo) Ping() bool {
+	cmd := exec.Command("git", "ls-remote", "--", r.URL)
+
+	return cmd.Run() == nil
+}
+
+// cloneArgs builds the argument list for `git clone`, always placing `--`
+// before the user-controlled url and dest so a name like "-rf" or
+// "--upload-pack=..." can't be interpreted as a git flag.
+func cloneArgs(url, dest string) []string {
+	return []string{"clone", "--", url, dest}
+}
+
+// checkoutArgs builds the argument list for `git checkout`, trailing the
+// user-controlled ref with `--` so it's resolved as a revision, not an
+// ambiguous pathspec. Putting `--` *before* ref instead would make git
+// treat ref as a pathspec to restore rather than a branch to switch to,
+// breaking every legitimate checkout. The trailing `--` does not stop git
+// from parsing a ref that starts with "-" as options ("-rf" is rejected as
+// an unknown switch, "--detach" is honoured), so Checkout refuses such refs
+// before calling git.
+func checkoutArgs(ref string) []string {
+	return []string{"checkout", ref, "--"}
+}
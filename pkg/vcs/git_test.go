@@ -0,0 +1,81 @@
+package vcs
+
+import (
+	"os/exec"
+	"reflect"
+	"testing"
+)
+
+func TestCloneArgsSeparatesUserInput(t *testing.T) {
+	cases := []struct {
+		name, url, dest string
+	}{
+		{"dash-prefixed dest", "https://example.com/repo.git", "-rf"},
+		{"flag smuggled as url", "--upload-pack=/bin/sh", "dest"},
+		{"path with spaces", "https://example.com/repo.git", "my project"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := cloneArgs(c.url, c.dest)
+			want := []string{"clone", "--", c.url, c.dest}
+
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("cloneArgs(%q, %q) = %v, want %v", c.url, c.dest, got, want)
+			}
+		})
+	}
+}
+
+func TestCheckoutArgsSeparatesRef(t *testing.T) {
+	cases := []string{"main", "-rf", "--upload-pack=/bin/sh"}
+
+	for _, ref := range cases {
+		got := checkoutArgs(ref)
+		want := []string{"checkout", ref, "--"}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("checkoutArgs(%q) = %v, want %v", ref, got, want)
+		}
+	}
+}
+
+// TestCheckoutArgsAgainstRealGit confirms checkoutArgs is both functional
+// and safe against real git: a legitimate branch name must actually be
+// checked out, while a hostile ref like "-rf" or "--upload-pack=..." must
+// never be executed as a flag.
+func TestCheckoutArgsAgainstRealGit(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not on PATH")
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) ([]byte, error) {
+		return exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput()
+	}
+
+	if out, err := run("init", "-q"); err != nil {
+		t.Fatalf("git init: %v\n%s", err, out)
+	}
+	if out, err := run("-c", "user.email=a@b.com", "-c", "user.name=t", "commit", "--allow-empty", "-q", "-m", "init"); err != nil {
+		t.Fatalf("git commit: %v\n%s", err, out)
+	}
+	if out, err := run("branch", "feature"); err != nil {
+		t.Fatalf("git branch: %v\n%s", err, out)
+	}
+
+	if out, err := run(checkoutArgs("feature")...); err != nil {
+		t.Fatalf("checkout of legitimate ref %q failed: %v\n%s", "feature", err, out)
+	}
+
+	if out, err := run("branch", "--show-current"); err != nil || string(out) != "feature\n" {
+		t.Fatalf("expected to be on branch feature, got %q (err %v)", out, err)
+	}
+
+	hostile := []string{"-rf", "--upload-pack=/bin/sh"}
+	for _, ref := range hostile {
+		if out, err := run(checkoutArgs(ref)...); err == nil {
+			t.Fatalf("checkout of hostile ref %q unexpectedly succeeded: %s", ref, out)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package vcs
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// LocalRepo clones a template from a directory already on disk, for
+// `--template ./local/path` sources.
+type LocalRepo struct {
+	Path string
+}
+
+// NewLocalRepo returns a Repo that copies files from a local directory.
+func NewLocalRepo(path string) *LocalRepo {
+	return &LocalRepo{Path: path}
+}
+
+func (r *LocalRepo) Clone(dest string) error {
+	if err := copyDir(r.Path, dest); err != nil {
+		return fmt.Errorf("copying %s: %w", r.Path, err)
+	}
+
+	return nil
+}
+
+func (r *LocalRepo) Checkout(ref string) error {
+	return fmt.Errorf("local sources don't support checking out %q; point --template at the desired revision instead", ref)
+}
+
+func (r *LocalRepo) Ping() bool {
+	info, err := os.Stat(r.Path)
+
+	return err == nil && info.IsDir()
+}
+
+// copyDir recursively copies src into dest, creating dest if necessary and
+// skipping a top-level .git directory so VCS internals aren't scaffolded
+// into the new project.
+func copyDir(src, dest string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+
+		if rel == ".git" {
+			return filepath.SkipDir
+		}
+
+		target := filepath.Join(dest, rel)
+
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode())
+		}
+
+		return copyFile(path, target, info.Mode())
+	})
+}
+
+func copyFile(src, dest string, mode os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		return err
+	}
+
+	out, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+
+	return err
+}
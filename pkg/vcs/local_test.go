@@ -0,0 +1,81 @@
+package vcs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLocalRepoClone(t *testing.T) {
+	src := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(src, "main.ts"), []byte("content"), 0o644); err != nil {
+		t.Fatalf("seeding source: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(src, "commands"), 0o755); err != nil {
+		t.Fatalf("seeding source: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(src, "commands", "ping.ts"), []byte("content"), 0o644); err != nil {
+		t.Fatalf("seeding source: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(src, ".git"), 0o755); err != nil {
+		t.Fatalf("seeding source: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(src, ".git", "HEAD"), []byte("ref: refs/heads/main"), 0o644); err != nil {
+		t.Fatalf("seeding source: %v", err)
+	}
+
+	dest := filepath.Join(t.TempDir(), "project")
+
+	repo := NewLocalRepo(src)
+	if err := repo.Clone(dest); err != nil {
+		t.Fatalf("Clone: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dest, "main.ts")); err != nil {
+		t.Errorf("expected main.ts to be copied: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dest, "commands", "ping.ts")); err != nil {
+		t.Errorf("expected commands/ping.ts to be copied: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dest, ".git")); err == nil {
+		t.Error(".git was copied into the scaffolded project, want it skipped")
+	}
+}
+
+func TestLocalRepoPing(t *testing.T) {
+	dir := t.TempDir()
+
+	if !NewLocalRepo(dir).Ping() {
+		t.Errorf("Ping() = false for an existing directory %s, want true", dir)
+	}
+
+	if NewLocalRepo(filepath.Join(dir, "does-not-exist")).Ping() {
+		t.Error("Ping() = true for a missing path, want false")
+	}
+}
+
+func TestNewDispatchesOnKind(t *testing.T) {
+	if _, ok := mustNew(t, "local", "/tmp/whatever").(*LocalRepo); !ok {
+		t.Error(`New("local", ...) did not return a *LocalRepo`)
+	}
+
+	if _, ok := mustNew(t, "git", "/tmp/whatever").(*GitRepo); !ok {
+		t.Error(`New("git", ...) did not return a *GitRepo`)
+	}
+
+	if _, ok := mustNew(t, "", "https://example.com/repo.zip").(*TarballRepo); !ok {
+		t.Error(`New("", "*.zip") did not return a *TarballRepo`)
+	}
+}
+
+func mustNew(t *testing.T, kind, source string) Repo {
+	t.Helper()
+
+	repo, err := New(kind, source)
+	if err != nil {
+		t.Fatalf("New(%q, %q): %v", kind, source, err)
+	}
+
+	return repo
+}
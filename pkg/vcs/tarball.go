@@ -0,0 +1,141 @@
+package vcs
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// TarballRepo clones a template from a downloadable zip archive, such as a
+// GitHub codeload URL, without requiring git to be installed.
+type TarballRepo struct {
+	URL string
+}
+
+// NewTarballRepo returns a Repo that downloads and unpacks a zip archive.
+func NewTarballRepo(url string) *TarballRepo {
+	return &TarballRepo{URL: url}
+}
+
+func (r *TarballRepo) Clone(dest string) error {
+	resp, err := http.Get(r.URL)
+	if err != nil {
+		return fmt.Errorf("downloading %s: %w", r.URL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("downloading %s: unexpected status %s", r.URL, resp.Status)
+	}
+
+	tmp, err := os.CreateTemp("", "sern-template-*.zip")
+	if err != nil {
+		return fmt.Errorf("buffering archive: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	if _, err := io.Copy(tmp, resp.Body); err != nil {
+		return fmt.Errorf("buffering archive: %w", err)
+	}
+
+	return extractZip(tmp.Name(), dest)
+}
+
+func (r *TarballRepo) Checkout(ref string) error {
+	return fmt.Errorf("tarball sources don't support checking out %q; fetch the archive at the desired ref instead", ref)
+}
+
+func (r *TarballRepo) Ping() bool {
+	resp, err := http.Head(r.URL)
+	if err != nil {
+		return false
+	}
+	defer resp.Body.Close()
+
+	return resp.StatusCode == http.StatusOK
+}
+
+// extractZip unpacks archive into dest, stripping the single top-level
+// directory GitHub codeload archives are wrapped in.
+func extractZip(archive, dest string) error {
+	zr, err := zip.OpenReader(archive)
+	if err != nil {
+		return fmt.Errorf("opening archive: %w", err)
+	}
+	defer zr.Close()
+
+	for _, f := range zr.File {
+		rel := stripTopLevelDir(f.Name)
+		if rel == "" {
+			continue
+		}
+
+		target, err := safeJoin(dest, rel)
+		if err != nil {
+			return fmt.Errorf("archive entry %q: %w", f.Name, err)
+		}
+
+		if f.FileInfo().IsDir() {
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return err
+		}
+
+		if err := copyZipFile(f, target); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func copyZipFile(f *zip.File, target string) error {
+	src, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	out, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, src)
+
+	return err
+}
+
+// safeJoin joins rel onto dest and guards against zip-slip: an archive
+// entry name containing ".." that would otherwise resolve outside dest.
+func safeJoin(dest, rel string) (string, error) {
+	target := filepath.Join(dest, rel)
+
+	relToDest, err := filepath.Rel(dest, target)
+	if err != nil || relToDest == ".." || strings.HasPrefix(relToDest, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%q escapes the destination directory", rel)
+	}
+
+	return target, nil
+}
+
+func stripTopLevelDir(name string) string {
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) < 2 {
+		return ""
+	}
+
+	return parts[1]
+}
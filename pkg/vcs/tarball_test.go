@@ -0,0 +1,77 @@
+package vcs
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeZip(t *testing.T, path string, names []string) {
+	t.Helper()
+
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("creating %s: %v", path, err)
+	}
+	defer f.Close()
+
+	zw := zip.NewWriter(f)
+	for _, name := range names {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("adding %s to archive: %v", name, err)
+		}
+
+		if _, err := w.Write([]byte("contents")); err != nil {
+			t.Fatalf("writing %s: %v", name, err)
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		t.Fatalf("closing archive: %v", err)
+	}
+}
+
+func TestExtractZipRejectsPathTraversal(t *testing.T) {
+	archiveDir := t.TempDir()
+	archive := filepath.Join(archiveDir, "template.zip")
+
+	writeZip(t, archive, []string{
+		"top/../../../../tmp/sern-zip-slip-escape",
+	})
+
+	dest := t.TempDir()
+
+	if err := extractZip(archive, dest); err == nil {
+		t.Fatal("extractZip did not reject a path-traversing archive entry")
+	}
+
+	if _, err := os.Stat(filepath.Join(dest, "..", "..", "..", "..", "tmp", "sern-zip-slip-escape")); err == nil {
+		t.Fatal("archive entry was written outside the destination directory")
+	}
+}
+
+func TestExtractZipWritesWellBehavedEntries(t *testing.T) {
+	archiveDir := t.TempDir()
+	archive := filepath.Join(archiveDir, "template.zip")
+
+	writeZip(t, archive, []string{
+		"top/main.ts",
+		"top/commands/ping.ts",
+	})
+
+	dest := t.TempDir()
+
+	if err := extractZip(archive, dest); err != nil {
+		t.Fatalf("extractZip: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dest, "main.ts")); err != nil {
+		t.Fatalf("expected main.ts to be extracted: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dest, "commands", "ping.ts")); err != nil {
+		t.Fatalf("expected commands/ping.ts to be extracted: %v", err)
+	}
+}
@@ -0,0 +1,55 @@
+// Package vcs abstracts fetching a starter template's files from a
+// version-controlled or archived source.
+package vcs
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Repo is a source that sern can clone and check out starter templates
+// from.
+type Repo interface {
+	Clone(dest string) error
+	Checkout(ref string) error
+	Ping() bool
+}
+
+// New selects a Repo implementation for source given its kind. "local"
+// copies a directory already on disk and "git" always clones with the git
+// binary; any other kind (including the empty string, for callers that
+// haven't resolved a kind) falls back to sniffing source itself — a zip
+// archive URL (including GitHub codeload links) is downloaded directly,
+// anything else is treated as a git remote.
+func New(kind, source string) (Repo, error) {
+	switch kind {
+	case "local":
+		return NewLocalRepo(source), nil
+	case "git":
+		return NewGitRepo(source), nil
+	}
+
+	if strings.HasSuffix(source, ".zip") || strings.Contains(source, "codeload.github.com") {
+		return NewTarballRepo(source), nil
+	}
+
+	return NewGitRepo(source), nil
+}
+
+// Revision returns the resolved commit SHA checked out at dir, or "" if
+// dir isn't a git checkout — a tarball source, for instance, has no
+// history to pin a revision to.
+func Revision(dir string) string {
+	if _, err := os.Stat(filepath.Join(dir, ".git")); err != nil {
+		return ""
+	}
+
+	out, err := exec.Command("git", "-C", dir, "rev-parse", "HEAD").Output()
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(out))
+}